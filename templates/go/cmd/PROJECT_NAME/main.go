@@ -2,24 +2,182 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+
+	"{{PROJECT_MODULE}}/internal/config"
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/etcd"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/postgres"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/redis"
+	greetergrpc "{{PROJECT_MODULE}}/internal/greeter/transport/grpc"
+	greeterhttp "{{PROJECT_MODULE}}/internal/greeter/transport/http"
+	greeterv1 "{{PROJECT_MODULE}}/proto/greeter/v1"
+)
+
+const (
+	configPath = "config.yaml"
+
+	shutdownTimeout = 5 * time.Second
 )
 
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
-	if err := run(ctx); err != nil {
+	if err := run(ctx, os.Args[1:]); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func run(ctx context.Context) error {
-	_ = ctx
-	fmt.Println("{{PROJECT_NAME}} starting...")
+// run loads configuration, wires the greeter service to both the REST
+// and gRPC listeners, and blocks until ctx is cancelled, at which
+// point it shuts both down gracefully. args is the command-line flags
+// to parse (os.Args[1:] in production; tests pass their own so they
+// aren't tripped up by the test binary's flags).
+func run(ctx context.Context, args []string) error {
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	tp, _, shutdownTelemetry, err := setupTelemetry(ctx, cfg.Telemetry)
+	if err != nil {
+		return fmt.Errorf("setting up telemetry: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := shutdownTelemetry(shutdownCtx); err != nil {
+			fmt.Fprintf(os.Stderr, "shutting down telemetry: %v\n", err)
+		}
+	}()
+
+	repo, closeRepo, err := newRepository(ctx, cfg.Repository)
+	if err != nil {
+		return fmt.Errorf("building repository: %w", err)
+	}
+	defer closeRepo()
+
+	svc, err := greeter.NewService(
+		greeter.WithRepository(repo),
+		greeter.WithDefaultLanguage(cfg.Greeter.DefaultLanguage),
+		greeter.WithLogger(newLogger(cfg.LogLevel)),
+		greeter.WithTracerProvider(tp),
+	)
+	if err != nil {
+		return fmt.Errorf("building greeter service: %w", err)
+	}
+
+	httpSrv := &http.Server{
+		Addr:    cfg.Server.HTTPAddr,
+		Handler: greeterhttp.NewHandler(svc),
+	}
+
+	metricsSrv := &http.Server{
+		Addr:    cfg.Telemetry.MetricsAddr,
+		Handler: promhttp.Handler(),
+	}
+
+	grpcLis, err := net.Listen("tcp", cfg.Server.GRPCAddr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", cfg.Server.GRPCAddr, err)
+	}
+	grpcSrv := grpc.NewServer(greetergrpc.UnaryInterceptor(svc))
+	greeterv1.RegisterGreeterServiceServer(grpcSrv, greetergrpc.NewServer(svc))
+
+	errc := make(chan error, 3)
+	go func() {
+		fmt.Printf("{{PROJECT_NAME}} HTTP listening on %s\n", cfg.Server.HTTPAddr)
+		if err := httpSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errc <- fmt.Errorf("http server: %w", err)
+		}
+	}()
+	go func() {
+		fmt.Printf("{{PROJECT_NAME}} gRPC listening on %s\n", cfg.Server.GRPCAddr)
+		if err := grpcSrv.Serve(grpcLis); err != nil {
+			errc <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+	go func() {
+		fmt.Printf("{{PROJECT_NAME}} metrics listening on %s\n", cfg.Telemetry.MetricsAddr)
+		if err := metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errc <- fmt.Errorf("metrics server: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errc:
+		return err
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutting down http server: %w", err)
+	}
+	if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutting down metrics server: %w", err)
+	}
+	grpcSrv.GracefulStop()
 	return nil
 }
+
+// loadConfig layers config.yaml, GREETER_* environment variables, and
+// command-line flags into a validated config.Config.
+func loadConfig(args []string) (*config.Config, error) {
+	cfg, err := config.Resolve(config.WithFile(configPath))
+	if err != nil {
+		return nil, err
+	}
+
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	config.RegisterFlags(fs, cfg)
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("parsing flags: %w", err)
+	}
+
+	return config.Load(config.WithFile(configPath), config.WithFlags(fs))
+}
+
+// newRepository builds the greeter.Repository selected by cfg.Backend
+// and a func that releases any resources it holds. cfg.DSN is
+// interpreted per backend: a Postgres connection string, a Redis
+// "host:port" address, or a comma-separated list of etcd endpoints.
+func newRepository(ctx context.Context, cfg config.Repository) (greeter.Repository, func(), error) {
+	switch cfg.Backend {
+	case "memory":
+		return greeter.NewMemoryRepository(), func() {}, nil
+	case "postgres":
+		repo, err := postgres.New(ctx, cfg.DSN)
+		if err != nil {
+			return nil, nil, fmt.Errorf("building postgres repository: %w", err)
+		}
+		return repo, repo.Close, nil
+	case "redis":
+		repo := redis.New(cfg.DSN, 0)
+		return repo, func() { _ = repo.Close() }, nil
+	case "etcd":
+		repo, err := etcd.New(strings.Split(cfg.DSN, ","))
+		if err != nil {
+			return nil, nil, fmt.Errorf("building etcd repository: %w", err)
+		}
+		return repo, func() { _ = repo.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported repository backend %q for this build; see internal/greeter/repo", cfg.Backend)
+	}
+}
@@ -3,13 +3,27 @@ package main
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestRun(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 
-	if err := run(ctx); err != nil {
-		t.Fatalf("run() returned error: %v", err)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- run(ctx, nil)
+	}()
+
+	// Give the listeners a moment to come up before requesting shutdown.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatalf("run() returned error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("run() did not return after context cancellation")
 	}
 }
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"{{PROJECT_MODULE}}/internal/config"
+)
+
+// setupTelemetry builds the trace.TracerProvider and metric.MeterProvider
+// for cfg, installs them as the OpenTelemetry globals, and returns a
+// shutdown func that flushes and releases both. When cfg.OTLPEndpoint
+// is empty, spans are recorded but not exported; the Prometheus
+// meter provider is always built, so greeter_* metrics are scrapeable
+// at cfg.MetricsAddr regardless.
+func setupTelemetry(ctx context.Context, cfg config.Telemetry) (trace.TracerProvider, metric.MeterProvider, func(context.Context) error, error) {
+	res, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("building telemetry resource: %w", err)
+	}
+
+	tp, tpShutdown, err := setupTracing(ctx, cfg, res)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	promExporter, err := prometheus.New()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("building prometheus exporter: %w", err)
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(promExporter),
+	)
+
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+
+	shutdown := func(ctx context.Context) error {
+		if err := tpShutdown(ctx); err != nil {
+			return fmt.Errorf("shutting down tracer provider: %w", err)
+		}
+		if err := mp.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutting down meter provider: %w", err)
+		}
+		return nil
+	}
+	return tp, mp, shutdown, nil
+}
+
+// setupTracing builds the TracerProvider for cfg.OTLPEndpoint, or one
+// with no exporter when it's empty, so running without a collector
+// configured exports nothing.
+func setupTracing(ctx context.Context, cfg config.Telemetry, res *sdkresource.Resource) (trace.TracerProvider, func(context.Context) error, error) {
+	if cfg.OTLPEndpoint == "" {
+		noop := sdktrace.NewTracerProvider(sdktrace.WithResource(res))
+		return noop, noop.Shutdown, nil
+	}
+
+	exp, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building OTLP trace exporter for %s: %w", cfg.OTLPEndpoint, err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithResource(res),
+		sdktrace.WithBatcher(exp),
+	)
+	return tp, tp.Shutdown, nil
+}
+
+// newLogger builds the process-wide *slog.Logger at the configured
+// level, logging as text to stderr.
+func newLogger(level string) *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel(level)}))
+}
+
+func logLevel(level string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
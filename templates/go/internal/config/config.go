@@ -0,0 +1,151 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// Config is the fully-resolved runtime configuration for
+// {{PROJECT_NAME}}, after the file, env, and flag layers have all been
+// applied.
+type Config struct {
+	Server     Server     `json:"server"`
+	Repository Repository `json:"repository"`
+	Greeter    Greeter    `json:"greeter"`
+	Telemetry  Telemetry  `json:"telemetry"`
+	LogLevel   string     `json:"log_level"`
+}
+
+// Server holds the listen addresses for the transport layer.
+type Server struct {
+	HTTPAddr string `json:"http_addr"`
+	GRPCAddr string `json:"grpc_addr"`
+}
+
+// Repository selects and configures the greeter.Repository backend.
+type Repository struct {
+	// Backend is one of "memory", "postgres", "redis", or "etcd".
+	Backend string `json:"backend"`
+	// DSN is the connection string/address for the selected backend:
+	// a Postgres connection string, a Redis "host:port" address, or a
+	// comma-separated list of etcd endpoints. Unused when Backend is
+	// "memory".
+	DSN string `json:"dsn"`
+}
+
+// Greeter holds defaults for the greeter.Service itself.
+type Greeter struct {
+	DefaultLanguage string `json:"default_language"`
+}
+
+// Telemetry configures where traces and metrics go. Leaving
+// OTLPEndpoint empty disables trace export; MetricsAddr always has a
+// default so /metrics is served even without explicit configuration.
+type Telemetry struct {
+	// ServiceName identifies this process in exported traces and
+	// metrics.
+	ServiceName string `json:"service_name"`
+	// OTLPEndpoint is the host:port of an OTLP/gRPC collector (e.g.
+	// Jaeger, Tempo, the OpenTelemetry Collector). Empty disables
+	// trace export; the Service still records spans, which are dropped.
+	OTLPEndpoint string `json:"otlp_endpoint"`
+	// MetricsAddr is the listen address for the Prometheus /metrics
+	// endpoint.
+	MetricsAddr string `json:"metrics_addr"`
+}
+
+// Default returns the configuration used when no file, env, or flag
+// overrides any given value.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			HTTPAddr: ":8080",
+			GRPCAddr: ":9090",
+		},
+		Repository: Repository{
+			Backend: "memory",
+		},
+		Greeter: Greeter{
+			DefaultLanguage: "en",
+		},
+		Telemetry: Telemetry{
+			ServiceName: "{{PROJECT_NAME}}",
+			MetricsAddr: ":9100",
+		},
+		LogLevel: "info",
+	}
+}
+
+var validBackends = map[string]bool{
+	"memory":   true,
+	"postgres": true,
+	"redis":    true,
+	"etcd":     true,
+}
+
+var validLogLevels = map[string]bool{
+	"debug": true,
+	"info":  true,
+	"warn":  true,
+	"error": true,
+}
+
+// Validate checks that every field holds a usable value, returning the
+// first problem found.
+func (c *Config) Validate() error {
+	if err := validateAddr(c.Server.HTTPAddr, "server.http_addr"); err != nil {
+		return err
+	}
+	if err := validateAddr(c.Server.GRPCAddr, "server.grpc_addr"); err != nil {
+		return err
+	}
+	if !validBackends[c.Repository.Backend] {
+		return fmt.Errorf("repository.backend: unknown backend %q", c.Repository.Backend)
+	}
+	if c.Repository.Backend != "memory" && c.Repository.DSN == "" {
+		return fmt.Errorf("repository.dsn: required for backend %q", c.Repository.Backend)
+	}
+	if c.Greeter.DefaultLanguage == "" {
+		return fmt.Errorf("greeter.default_language: must not be empty")
+	}
+	if c.Telemetry.ServiceName == "" {
+		return fmt.Errorf("telemetry.service_name: must not be empty")
+	}
+	if err := validateAddr(c.Telemetry.MetricsAddr, "telemetry.metrics_addr"); err != nil {
+		return err
+	}
+	if !validLogLevels[c.LogLevel] {
+		return fmt.Errorf("log_level: unknown level %q", c.LogLevel)
+	}
+	return nil
+}
+
+func validateAddr(addr, field string) error {
+	if addr == "" {
+		return fmt.Errorf("%s: must not be empty", field)
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("%s: invalid address %q: %w", field, addr, err)
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("%s: invalid port in %q", field, addr)
+	}
+	return nil
+}
+
+// String renders the config for logs, redacting the DSN since it may
+// carry credentials.
+func (c *Config) String() string {
+	dsn := c.Repository.DSN
+	if dsn != "" {
+		dsn = "<redacted>"
+	}
+	return fmt.Sprintf(
+		"Config{Server:{HTTPAddr:%s GRPCAddr:%s} Repository:{Backend:%s DSN:%s} Greeter:{DefaultLanguage:%s} Telemetry:{ServiceName:%s OTLPEndpoint:%s MetricsAddr:%s} LogLevel:%s}",
+		c.Server.HTTPAddr, c.Server.GRPCAddr, c.Repository.Backend, dsn, c.Greeter.DefaultLanguage,
+		c.Telemetry.ServiceName, c.Telemetry.OTLPEndpoint, c.Telemetry.MetricsAddr, c.LogLevel,
+	)
+}
@@ -0,0 +1,116 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"{{PROJECT_MODULE}}/internal/config"
+)
+
+func TestLoad_Defaults(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.HTTPAddr != ":8080" {
+		t.Errorf("HTTPAddr = %q, want %q", cfg.Server.HTTPAddr, ":8080")
+	}
+	if cfg.Repository.Backend != "memory" {
+		t.Errorf("Backend = %q, want %q", cfg.Repository.Backend, "memory")
+	}
+	if cfg.Telemetry.MetricsAddr != ":9100" {
+		t.Errorf("MetricsAddr = %q, want %q", cfg.Telemetry.MetricsAddr, ":9100")
+	}
+	if cfg.Telemetry.OTLPEndpoint != "" {
+		t.Errorf("OTLPEndpoint = %q, want empty", cfg.Telemetry.OTLPEndpoint)
+	}
+}
+
+func TestLoad_FileOverridesDefaults(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := "server:\n  http_addr: \":9999\"\ngreeter:\n  default_language: \"es\"\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+
+	cfg, err := config.Load(config.WithFile(path))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.HTTPAddr != ":9999" {
+		t.Errorf("HTTPAddr = %q, want %q", cfg.Server.HTTPAddr, ":9999")
+	}
+	if cfg.Greeter.DefaultLanguage != "es" {
+		t.Errorf("DefaultLanguage = %q, want %q", cfg.Greeter.DefaultLanguage, "es")
+	}
+	// Untouched by the file, still the default.
+	if cfg.Server.GRPCAddr != ":9090" {
+		t.Errorf("GRPCAddr = %q, want %q", cfg.Server.GRPCAddr, ":9090")
+	}
+}
+
+func TestLoad_EnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server:\n  http_addr: \":9999\"\n"), 0o600); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+	t.Setenv("GREETER_SERVER_HTTP_ADDR", ":7777")
+
+	cfg, err := config.Load(config.WithFile(path))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.HTTPAddr != ":7777" {
+		t.Errorf("HTTPAddr = %q, want %q", cfg.Server.HTTPAddr, ":7777")
+	}
+}
+
+func TestLoad_FlagsOverrideEnv(t *testing.T) {
+	t.Setenv("GREETER_SERVER_HTTP_ADDR", ":7777")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	config.RegisterFlags(fs, config.Default())
+	if err := fs.Parse([]string{"-server.http-addr=:6666"}); err != nil {
+		t.Fatalf("parsing flags: %v", err)
+	}
+
+	cfg, err := config.Load(config.WithFlags(fs))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.HTTPAddr != ":6666" {
+		t.Errorf("HTTPAddr = %q, want %q", cfg.Server.HTTPAddr, ":6666")
+	}
+}
+
+func TestLoad_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+	}{
+		{"bad backend", map[string]string{"GREETER_REPOSITORY_BACKEND": "mongo"}},
+		{"missing dsn for non-memory backend", map[string]string{"GREETER_REPOSITORY_BACKEND": "postgres"}},
+		{"empty default language", map[string]string{"GREETER_GREETER_DEFAULT_LANGUAGE": ""}},
+		{"bad log level", map[string]string{"GREETER_LOG_LEVEL": "trace"}},
+		{"bad http addr", map[string]string{"GREETER_SERVER_HTTP_ADDR": "not-an-addr"}},
+		{"empty service name", map[string]string{"GREETER_TELEMETRY_SERVICE_NAME": ""}},
+		{"bad metrics addr", map[string]string{"GREETER_TELEMETRY_METRICS_ADDR": "not-an-addr"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			if _, err := config.Load(); err == nil {
+				t.Fatal("expected validation error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Package config loads {{PROJECT_NAME}}'s runtime configuration by
+// layering a YAML (or JSON) file, GREETER_* environment variables, and
+// command-line flags, in that order — each layer overrides the one
+// before it. Call Load to get a validated Config, and OnChange to be
+// notified when the underlying file changes on disk.
+package config
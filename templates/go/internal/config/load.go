@@ -0,0 +1,166 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ghodss/yaml"
+)
+
+// EnvPrefix is the prefix applied to every environment variable
+// recognised by the env layer.
+const EnvPrefix = "GREETER_"
+
+// Option configures Load via the functional options pattern.
+type Option func(*loadState) error
+
+type loadState struct {
+	filePath string
+	flagSet  *flag.FlagSet
+}
+
+// WithFile layers path (YAML or JSON, via ghodss/yaml) over the
+// defaults. Missing files are not an error — Load falls back to
+// Default() and lets the env/flag layers take over.
+func WithFile(path string) Option {
+	return func(s *loadState) error {
+		s.filePath = path
+		return nil
+	}
+}
+
+// WithFlags layers the flags explicitly set in fs (after RegisterFlags
+// has defined them) as the final, highest-priority layer. fs must already be parsed;
+// Load only reads its values.
+func WithFlags(fs *flag.FlagSet) Option {
+	return func(s *loadState) error {
+		s.flagSet = fs
+		return nil
+	}
+}
+
+// Load builds a Config by layering, in increasing priority: built-in
+// defaults, an optional file (WithFile), GREETER_* environment
+// variables, and flags (WithFlags). The result is validated before
+// it's returned.
+func Load(opts ...Option) (*Config, error) {
+	cfg, err := Resolve(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Resolve applies opts the same way Load does but skips validation,
+// for callers that need an intermediate, possibly-incomplete Config —
+// e.g. to seed RegisterFlags with the file/env layers before the flag
+// layer has run, when a field required overall (like repository.dsn)
+// might only be supplied by a flag.
+func Resolve(opts ...Option) (*Config, error) {
+	var state loadState
+	for _, opt := range opts {
+		if err := opt(&state); err != nil {
+			return nil, fmt.Errorf("applying config option: %w", err)
+		}
+	}
+
+	cfg := Default()
+
+	if state.filePath != "" {
+		if err := overlayFile(cfg, state.filePath); err != nil {
+			return nil, err
+		}
+	}
+
+	overlayEnv(cfg)
+
+	if state.flagSet != nil {
+		overlayFlags(cfg, state.flagSet)
+	}
+
+	return cfg, nil
+}
+
+func overlayFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading config file %q: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("parsing config file %q: %w", path, err)
+	}
+	return nil
+}
+
+func overlayEnv(cfg *Config) {
+	setFromEnv(&cfg.Server.HTTPAddr, "SERVER_HTTP_ADDR")
+	setFromEnv(&cfg.Server.GRPCAddr, "SERVER_GRPC_ADDR")
+	setFromEnv(&cfg.Repository.Backend, "REPOSITORY_BACKEND")
+	setFromEnv(&cfg.Repository.DSN, "REPOSITORY_DSN")
+	setFromEnv(&cfg.Greeter.DefaultLanguage, "GREETER_DEFAULT_LANGUAGE")
+	setFromEnv(&cfg.Telemetry.ServiceName, "TELEMETRY_SERVICE_NAME")
+	setFromEnv(&cfg.Telemetry.OTLPEndpoint, "TELEMETRY_OTLP_ENDPOINT")
+	setFromEnv(&cfg.Telemetry.MetricsAddr, "TELEMETRY_METRICS_ADDR")
+	setFromEnv(&cfg.LogLevel, "LOG_LEVEL")
+}
+
+func setFromEnv(dst *string, suffix string) {
+	if v, ok := os.LookupEnv(EnvPrefix + suffix); ok {
+		*dst = v
+	}
+}
+
+// RegisterFlags defines one flag on fs for each of cfg's fields,
+// defaulting each flag to cfg's current value; cfg itself is not
+// written by fs.Parse. Call it after Resolve's file/env layers have
+// run but before fs.Parse, then pass fs to Load via WithFlags so the
+// parsed values become the final overlay. Seed with Resolve, not Load
+// — a field Validate requires (like repository.dsn) might only be
+// supplied by a flag, and Load would reject the seed config before the
+// flag layer ever runs:
+//
+//	cfg, _ := config.Resolve(config.WithFile(path))
+//	config.RegisterFlags(flag.CommandLine, cfg)
+//	flag.Parse()
+//	cfg, err := config.Load(config.WithFile(path), config.WithFlags(flag.CommandLine))
+func RegisterFlags(fs *flag.FlagSet, cfg *Config) {
+	fs.String("server.http-addr", cfg.Server.HTTPAddr, "HTTP listen address")
+	fs.String("server.grpc-addr", cfg.Server.GRPCAddr, "gRPC listen address")
+	fs.String("repository.backend", cfg.Repository.Backend, "repository backend: memory, postgres, redis, or etcd")
+	fs.String("repository.dsn", cfg.Repository.DSN, "repository connection string/address")
+	fs.String("greeter.default-language", cfg.Greeter.DefaultLanguage, "default greeting language")
+	fs.String("telemetry.service-name", cfg.Telemetry.ServiceName, "service name reported in traces and metrics")
+	fs.String("telemetry.otlp-endpoint", cfg.Telemetry.OTLPEndpoint, "OTLP/gRPC collector address; empty disables trace export")
+	fs.String("telemetry.metrics-addr", cfg.Telemetry.MetricsAddr, "Prometheus /metrics listen address")
+	fs.String("log-level", cfg.LogLevel, "log level: debug, info, warn, or error")
+}
+
+func overlayFlags(cfg *Config, fs *flag.FlagSet) {
+	overlayFlag(fs, "server.http-addr", &cfg.Server.HTTPAddr)
+	overlayFlag(fs, "server.grpc-addr", &cfg.Server.GRPCAddr)
+	overlayFlag(fs, "repository.backend", &cfg.Repository.Backend)
+	overlayFlag(fs, "repository.dsn", &cfg.Repository.DSN)
+	overlayFlag(fs, "greeter.default-language", &cfg.Greeter.DefaultLanguage)
+	overlayFlag(fs, "telemetry.service-name", &cfg.Telemetry.ServiceName)
+	overlayFlag(fs, "telemetry.otlp-endpoint", &cfg.Telemetry.OTLPEndpoint)
+	overlayFlag(fs, "telemetry.metrics-addr", &cfg.Telemetry.MetricsAddr)
+	overlayFlag(fs, "log-level", &cfg.LogLevel)
+}
+
+// overlayFlag only applies a flag's value if it was explicitly set on
+// the command line, so an unset flag doesn't clobber the file/env
+// layers with the default it was registered with.
+func overlayFlag(fs *flag.FlagSet, name string, dst *string) {
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			*dst = f.Value.String()
+		}
+	})
+}
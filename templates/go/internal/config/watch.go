@@ -0,0 +1,52 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// OnChange watches path and calls fn with a freshly reloaded, validated
+// Config every time the file changes on disk. Reloads that fail
+// validation or can't be parsed are logged and skipped — fn is never
+// called with an invalid Config. The watch stops when ctx is done.
+func OnChange(ctx context.Context, path string, fn func(*Config)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				cfg, err := Load(WithFile(path))
+				if err != nil {
+					slog.Error("config: reload skipped", "path", path, "error", err)
+					continue
+				}
+				fn(cfg)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				slog.Error("config: watch error", "path", path, "error", err)
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import "context"
+
+// Scope identifies the breadth of access an Account has been granted.
+type Scope string
+
+const (
+	// ScopePublic grants access to actions on any account's resources.
+	ScopePublic Scope = "public"
+	// ScopeAccount grants access only to the caller's own resources.
+	ScopeAccount Scope = "account"
+)
+
+// Account identifies the caller a token was issued to.
+type Account struct {
+	ID     string
+	Scopes []string
+}
+
+// HasScope reports whether the account was granted scope.
+func (a Account) HasScope(scope Scope) bool {
+	for _, s := range a.Scopes {
+		if s == string(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource identifies the thing an Authorizer is deciding access to.
+type Resource struct {
+	Type   string // e.g. "greeting"
+	Action string // e.g. "create", "get", "list"
+}
+
+// Authenticator turns a raw bearer token into the Account it was
+// issued to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Account, error)
+}
+
+// Authorizer decides whether acc may perform Action on Resource.
+type Authorizer interface {
+	Verify(ctx context.Context, acc Account, res Resource) error
+}
+
+type contextKey struct{}
+
+// WithAccount returns a copy of ctx carrying acc, for transport
+// middleware to call after a successful Authenticate.
+func WithAccount(ctx context.Context, acc Account) context.Context {
+	return context.WithValue(ctx, contextKey{}, acc)
+}
+
+// AccountFromContext returns the Account populated by WithAccount, if
+// any. ok is false when the request was unauthenticated.
+func AccountFromContext(ctx context.Context) (acc Account, ok bool) {
+	acc, ok = ctx.Value(contextKey{}).(Account)
+	return acc, ok
+}
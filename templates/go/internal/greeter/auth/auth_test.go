@@ -0,0 +1,139 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+var hmacSecret = []byte("test-secret")
+
+func signHS256(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(hmacSecret)
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return signed
+}
+
+func keyFunc(_ *jwt.Token) (any, error) {
+	return hmacSecret, nil
+}
+
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewJWTAuthenticator(keyFunc)
+
+	token := signHS256(t, jwt.MapClaims{
+		"sub":    "user-1",
+		"scopes": "account public",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	})
+
+	acc, err := authn.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if acc.ID != "user-1" {
+		t.Errorf("ID = %q, want %q", acc.ID, "user-1")
+	}
+	if !acc.HasScope(auth.ScopeAccount) || !acc.HasScope(auth.ScopePublic) {
+		t.Errorf("Scopes = %v, want both account and public", acc.Scopes)
+	}
+}
+
+func TestJWTAuthenticator_InvalidToken(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewJWTAuthenticator(keyFunc)
+
+	_, err := authn.Authenticate(context.Background(), "not-a-jwt")
+	if !errors.Is(err, errs.ErrInvalidToken) {
+		t.Fatalf("expected errors.Is(err, errs.ErrInvalidToken), got %v", err)
+	}
+}
+
+func TestJWTAuthenticator_MissingSubject(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewJWTAuthenticator(keyFunc)
+
+	token := signHS256(t, jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()})
+
+	_, err := authn.Authenticate(context.Background(), token)
+	if !errors.Is(err, errs.ErrInvalidToken) {
+		t.Fatalf("expected errors.Is(err, errs.ErrInvalidToken), got %v", err)
+	}
+}
+
+func TestStaticAuthorizer_Verify(t *testing.T) {
+	t.Parallel()
+
+	authz := auth.NewStaticAuthorizer(
+		auth.Rule{Scope: auth.ScopeAccount, Type: "greeting", Action: "create"},
+		auth.Rule{Scope: auth.ScopePublic, Type: "greeting"},
+	)
+
+	tests := []struct {
+		name    string
+		acc     auth.Account
+		res     auth.Resource
+		wantErr bool
+	}{
+		{
+			name: "account scope may create",
+			acc:  auth.Account{ID: "u1", Scopes: []string{"account"}},
+			res:  auth.Resource{Type: "greeting", Action: "create"},
+		},
+		{
+			name:    "account scope may not list",
+			acc:     auth.Account{ID: "u1", Scopes: []string{"account"}},
+			res:     auth.Resource{Type: "greeting", Action: "list"},
+			wantErr: true,
+		},
+		{
+			name: "public scope may do anything to greetings",
+			acc:  auth.Account{ID: "u2", Scopes: []string{"public"}},
+			res:  auth.Resource{Type: "greeting", Action: "list"},
+		},
+		{
+			name:    "no matching scope",
+			acc:     auth.Account{ID: "u3"},
+			res:     auth.Resource{Type: "greeting", Action: "create"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := authz.Verify(context.Background(), tt.acc, tt.res)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, errs.ErrForbidden) {
+				t.Errorf("expected errors.Is(err, errs.ErrForbidden), got %v", err)
+			}
+		})
+	}
+}
+
+func TestAccountFromContext(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := auth.AccountFromContext(context.Background()); ok {
+		t.Fatal("expected no account in bare context")
+	}
+
+	ctx := auth.WithAccount(context.Background(), auth.Account{ID: "u1"})
+	acc, ok := auth.AccountFromContext(ctx)
+	if !ok || acc.ID != "u1" {
+		t.Fatalf("AccountFromContext = %+v, %v, want {ID:u1}, true", acc, ok)
+	}
+}
@@ -0,0 +1,7 @@
+// Package auth defines the greeter Service's authentication and
+// authorization contracts — Authenticator turns a bearer token into an
+// Account, Authorizer decides whether that Account may act on a
+// Resource — along with a JWT-backed Authenticator and a static-rules
+// Authorizer. Transport middleware populates the context with an
+// Account; Service reads it back via AccountFromContext.
+package auth
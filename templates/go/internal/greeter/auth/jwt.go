@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+// JWTAuthenticator verifies bearer tokens as JWTs and maps their
+// claims onto an Account. The subject claim ("sub") becomes Account.ID
+// and a "scopes" claim (a space-separated string) becomes Account.Scopes.
+type JWTAuthenticator struct {
+	keyFunc jwt.Keyfunc
+}
+
+// NewJWTAuthenticator returns a JWTAuthenticator that verifies tokens
+// using keyFunc — typically a JWKS-backed key lookup, e.g. from
+// github.com/MicahParks/keyfunc, but any jwt.Keyfunc works (a fixed
+// HMAC secret is common in tests).
+func NewJWTAuthenticator(keyFunc jwt.Keyfunc) *JWTAuthenticator {
+	return &JWTAuthenticator{keyFunc: keyFunc}
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, token string) (Account, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, a.keyFunc)
+	if err != nil {
+		return Account{}, errs.WithCausef(errs.ErrInvalidToken, "%v", err)
+	}
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return Account{}, errs.WithCausef(errs.ErrInvalidToken, "missing sub claim")
+	}
+
+	var scopes []string
+	if raw, ok := claims["scopes"].(string); ok {
+		scopes = strings.Fields(raw)
+	}
+	return Account{ID: sub, Scopes: scopes}, nil
+}
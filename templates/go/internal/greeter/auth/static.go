@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+// Rule grants any account with Scope the ability to perform Action on
+// resources of Type. An empty Action matches any action.
+type Rule struct {
+	Scope  Scope
+	Type   string
+	Action string
+}
+
+// StaticAuthorizer is an Authorizer backed by a fixed set of Rules,
+// evaluated against the account's scopes.
+type StaticAuthorizer struct {
+	rules []Rule
+}
+
+// NewStaticAuthorizer returns an Authorizer that permits a Resource
+// only when the account holds a scope matched by one of rules.
+func NewStaticAuthorizer(rules ...Rule) *StaticAuthorizer {
+	return &StaticAuthorizer{rules: rules}
+}
+
+func (a *StaticAuthorizer) Verify(_ context.Context, acc Account, res Resource) error {
+	for _, r := range a.rules {
+		if r.Type != res.Type {
+			continue
+		}
+		if r.Action != "" && r.Action != res.Action {
+			continue
+		}
+		if acc.HasScope(r.Scope) {
+			return nil
+		}
+	}
+	return errs.WithCausef(errs.ErrForbidden, "account %q may not %s %s", acc.ID, res.Action, res.Type)
+}
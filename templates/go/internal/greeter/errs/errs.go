@@ -0,0 +1,57 @@
+// Package errs defines the sentinel errors shared across the greeter
+// Service and Repository implementations, plus a small helper for
+// wrapping them with caller-specific detail that errors.Is can still
+// see through.
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors returned (possibly wrapped via WithCausef) by
+// Service and Repository methods. Callers should match on these with
+// errors.Is rather than inspecting error strings.
+var (
+	// ErrNotFound indicates the requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput indicates the caller-supplied input failed validation.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrConflict indicates the request conflicts with existing state
+	// (e.g. a duplicate ID).
+	ErrConflict = errors.New("conflict")
+	// ErrForbidden indicates the caller is not permitted to perform
+	// the requested action.
+	ErrForbidden = errors.New("forbidden")
+	// ErrInvalidToken indicates the caller's bearer token is missing,
+	// malformed, or failed verification.
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// WithCausef wraps sentinel with a formatted message while keeping it
+// discoverable via errors.Is(err, sentinel). The %w verb is supplied
+// automatically — callers just provide the detail and its args.
+func WithCausef(sentinel error, format string, args ...any) error {
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), sentinel)
+}
+
+// HTTPStatus maps an error produced by this package's sentinels to the
+// HTTP status code a transport layer should respond with. Unrecognized
+// errors map to 500.
+func HTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, ErrInvalidToken):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,43 @@
+package errs_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+func TestWithCausef_IsSentinel(t *testing.T) {
+	t.Parallel()
+
+	err := errs.WithCausef(errs.ErrNotFound, "greeting %q", "abc123")
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected errors.Is(err, ErrNotFound), err = %v", err)
+	}
+	if got, want := err.Error(), `greeting "abc123": not found`; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{errs.WithCausef(errs.ErrNotFound, "x"), http.StatusNotFound},
+		{errs.WithCausef(errs.ErrInvalidInput, "x"), http.StatusBadRequest},
+		{errs.WithCausef(errs.ErrConflict, "x"), http.StatusConflict},
+		{errs.WithCausef(errs.ErrForbidden, "x"), http.StatusForbidden},
+		{errs.WithCausef(errs.ErrInvalidToken, "x"), http.StatusUnauthorized},
+		{errors.New("unmapped"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		if got := errs.HTTPStatus(tt.err); got != tt.want {
+			t.Errorf("HTTPStatus(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
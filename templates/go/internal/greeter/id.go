@@ -0,0 +1,101 @@
+package greeter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// uuidv7Sequence generates RFC 9562 UUIDv7 values from a clock. Within
+// the same millisecond it keeps values monotonically increasing by
+// incrementing the previous rand_a counter instead of re-randomizing
+// it, so IDs issued in quick succession still sort in issue order.
+type uuidv7Sequence struct {
+	mu        sync.Mutex
+	lastMs    int64
+	lastRandA uint16 // 12 bits
+}
+
+// next returns the next UUIDv7 string for timestamp now.
+func (s *uuidv7Sequence) next(now time.Time) string {
+	ms := now.UnixMilli()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var randA uint16
+	if ms <= s.lastMs {
+		ms = s.lastMs
+		s.lastRandA++
+		if s.lastRandA > 0x0FFF {
+			// rand_a overflowed: advance the timestamp instead of
+			// wrapping, per RFC 9562 section 6.2 method 2, so IDs
+			// stay monotonic even past 4096 in the same millisecond.
+			ms++
+			s.lastRandA = 0
+		}
+		randA = s.lastRandA
+	} else {
+		randA = randomRandA()
+		s.lastRandA = randA
+	}
+	s.lastMs = ms
+
+	return encodeUUIDv7(ms, randA)
+}
+
+func randomRandA() uint16 {
+	var b [2]byte
+	_, _ = rand.Read(b[:])
+	return (uint16(b[0])<<8 | uint16(b[1])) & 0x0FFF
+}
+
+// encodeUUIDv7 lays out a UUIDv7 octet sequence per RFC 9562 section 5.7:
+// a 48-bit big-endian millisecond timestamp, 4 version bits (0b0111),
+// 12 bits of rand_a, 2 variant bits (0b10), and 62 random bits of
+// rand_b, rendered as the canonical 36-character hyphenated string.
+func encodeUUIDv7(ms int64, randA uint16) string {
+	var u [16]byte
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+
+	u[6] = 0x70 | byte(randA>>8&0x0F) // version 0111
+	u[7] = byte(randA)
+
+	var randB [8]byte
+	_, _ = rand.Read(randB[:])
+	randB[0] = 0x80 | (randB[0] & 0x3F) // variant 10
+	copy(u[8:], randB[:])
+
+	return fmt.Sprintf("%s-%s-%s-%s-%s",
+		hex.EncodeToString(u[0:4]),
+		hex.EncodeToString(u[4:6]),
+		hex.EncodeToString(u[6:8]),
+		hex.EncodeToString(u[8:10]),
+		hex.EncodeToString(u[10:16]),
+	)
+}
+
+// ParseID extracts the Unix millisecond timestamp embedded in a
+// UUIDv7 id, for debugging when a greeting was created. It accepts any
+// canonical 36-character UUID; the version/variant bits aren't checked,
+// since the timestamp bytes are the only part a caller typically wants.
+func ParseID(id string) (time.Time, error) {
+	if len(id) != 36 || id[8] != '-' || id[13] != '-' || id[18] != '-' || id[23] != '-' {
+		return time.Time{}, fmt.Errorf("parsing id %q: not a canonical UUID", id)
+	}
+	raw, err := hex.DecodeString(id[0:8] + id[9:13] + id[14:18] + id[19:23] + id[24:36])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing id %q: %w", id, err)
+	}
+
+	ms := int64(raw[0])<<40 | int64(raw[1])<<32 | int64(raw[2])<<24 |
+		int64(raw[3])<<16 | int64(raw[4])<<8 | int64(raw[5])
+	return time.UnixMilli(ms).UTC(), nil
+}
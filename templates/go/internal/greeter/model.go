@@ -7,11 +7,16 @@ import (
 
 // Greeting is the core domain model.
 type Greeting struct {
+	// ID is a UUIDv7 string — see greeter.ParseID to recover its
+	// embedded creation timestamp.
 	ID        string    `json:"id"`
 	Name      string    `json:"name"`
 	Language  string    `json:"language"`
 	Message   string    `json:"message"`
 	CreatedAt time.Time `json:"created_at"`
+	// OwnerID is the Account.ID of the caller who created this
+	// greeting, or empty when the Service has no auth configured.
+	OwnerID string `json:"owner_id,omitempty"`
 }
 
 // CreateInput holds validated input for creating a greeting.
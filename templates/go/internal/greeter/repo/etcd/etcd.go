@@ -0,0 +1,96 @@
+// Package etcd implements greeter.Repository on top of etcd. Greetings
+// are stored as JSON values under a common key prefix, following the
+// Get/Set-with-prefix pattern used by etcd's KeysAPI.
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+const keyPrefix = "/greeter/greetings/"
+
+func keyFor(id string) string {
+	return keyPrefix + id
+}
+
+// Repository is a greeter.Repository backed by etcd.
+type Repository struct {
+	client *clientv3.Client
+}
+
+// New returns a Repository connected to the given etcd endpoints.
+func New(endpoints []string) (*Repository, error) {
+	client, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		return nil, fmt.Errorf("connecting to etcd: %w", err)
+	}
+	return &Repository{client: client}, nil
+}
+
+// Close releases the underlying client connection.
+func (r *Repository) Close() error {
+	return r.client.Close()
+}
+
+func (r *Repository) Store(ctx context.Context, g greeter.Greeting) error {
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Errorf("marshaling greeting %q: %w", g.ID, err)
+	}
+
+	// Use a transaction so the duplicate-ID check and the write are
+	// atomic: only proceed if the key is still absent (CreateRevision == 0).
+	key := keyFor(g.ID)
+	txn := r.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(data)))
+
+	resp, err := txn.Commit()
+	if err != nil {
+		return fmt.Errorf("storing greeting %q: %w", g.ID, err)
+	}
+	if !resp.Succeeded {
+		return errs.WithCausef(errs.ErrConflict, "greeting %q already exists", g.ID)
+	}
+	return nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (greeter.Greeting, error) {
+	resp, err := r.client.Get(ctx, keyFor(id))
+	if err != nil {
+		return greeter.Greeting{}, fmt.Errorf("finding greeting %q: %w", id, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return greeter.Greeting{}, errs.WithCausef(errs.ErrNotFound, "greeting %q", id)
+	}
+
+	var g greeter.Greeting
+	if err := json.Unmarshal(resp.Kvs[0].Value, &g); err != nil {
+		return greeter.Greeting{}, fmt.Errorf("unmarshaling greeting %q: %w", id, err)
+	}
+	return g, nil
+}
+
+func (r *Repository) List(ctx context.Context) ([]greeter.Greeting, error) {
+	resp, err := r.client.Get(ctx, keyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("listing greetings: %w", err)
+	}
+
+	out := make([]greeter.Greeting, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var g greeter.Greeting
+		if err := json.Unmarshal(kv.Value, &g); err != nil {
+			return nil, fmt.Errorf("unmarshaling greeting at %q: %w", kv.Key, err)
+		}
+		out = append(out, g)
+	}
+	return out, nil
+}
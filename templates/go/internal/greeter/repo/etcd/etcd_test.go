@@ -0,0 +1,47 @@
+package etcd_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/etcd"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/repotest"
+)
+
+// TestEtcdRepository_Conformance requires a live etcd cluster. Set
+// ETCD_ENDPOINTS (comma-separated) to run it, e.g.:
+//
+//	ETCD_ENDPOINTS=localhost:2379 go test ./...
+func TestEtcdRepository_Conformance(t *testing.T) {
+	endpoints := os.Getenv("ETCD_ENDPOINTS")
+	if endpoints == "" {
+		t.Skip("ETCD_ENDPOINTS not set; skipping etcd conformance test")
+	}
+	eps := strings.Split(endpoints, ",")
+
+	repotest.RunConformance(t, func(t *testing.T) greeter.Repository {
+		t.Helper()
+		ctx := context.Background()
+
+		client, err := clientv3.New(clientv3.Config{Endpoints: eps})
+		if err != nil {
+			t.Fatalf("clientv3.New: %v", err)
+		}
+		defer client.Close()
+		if _, err := client.Delete(ctx, "/greeter/greetings/", clientv3.WithPrefix()); err != nil {
+			t.Fatalf("clearing /greeter/greetings/ prefix: %v", err)
+		}
+
+		repo, err := etcd.New(eps)
+		if err != nil {
+			t.Fatalf("etcd.New: %v", err)
+		}
+		t.Cleanup(func() { _ = repo.Close() })
+		return repo
+	})
+}
@@ -0,0 +1,131 @@
+// Package postgres implements greeter.Repository on top of Postgres via
+// pgx, with schema migrations in ./migrations applied through
+// golang-migrate.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratepgx "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib" // registers the "pgx" database/sql driver, used only for migrations
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Repository is a greeter.Repository backed by Postgres. It is safe
+// for concurrent use — all access goes through a pgxpool.Pool.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// New connects to dsn, applies any pending migrations, and returns a
+// ready-to-use Repository. Callers are responsible for calling Close.
+func New(ctx context.Context, dsn string) (*Repository, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to postgres: %w", err)
+	}
+	if err := runMigrations(dsn); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("applying migrations: %w", err)
+	}
+	return &Repository{pool: pool}, nil
+}
+
+// Close releases the underlying connection pool.
+func (r *Repository) Close() {
+	r.pool.Close()
+}
+
+func (r *Repository) Store(ctx context.Context, g greeter.Greeting) error {
+	const q = `INSERT INTO greetings (id, name, language, message, created_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := r.pool.Exec(ctx, q, g.ID, g.Name, g.Language, g.Message, g.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.WithCausef(errs.ErrConflict, "greeting %q already exists", g.ID)
+		}
+		return fmt.Errorf("storing greeting %q: %w", g.ID, err)
+	}
+	return nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (greeter.Greeting, error) {
+	const q = `SELECT id, name, language, message, created_at FROM greetings WHERE id = $1`
+	var g greeter.Greeting
+	err := r.pool.QueryRow(ctx, q, id).Scan(&g.ID, &g.Name, &g.Language, &g.Message, &g.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return greeter.Greeting{}, errs.WithCausef(errs.ErrNotFound, "greeting %q", id)
+	}
+	if err != nil {
+		return greeter.Greeting{}, fmt.Errorf("finding greeting %q: %w", id, err)
+	}
+	return g, nil
+}
+
+func (r *Repository) List(ctx context.Context) ([]greeter.Greeting, error) {
+	const q = `SELECT id, name, language, message, created_at FROM greetings ORDER BY created_at`
+	rows, err := r.pool.Query(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("listing greetings: %w", err)
+	}
+	defer rows.Close()
+
+	var out []greeter.Greeting
+	for rows.Next() {
+		var g greeter.Greeting
+		if err := rows.Scan(&g.ID, &g.Name, &g.Language, &g.Message, &g.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning greeting row: %w", err)
+		}
+		out = append(out, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing greetings: %w", err)
+	}
+	return out, nil
+}
+
+func runMigrations(dsn string) error {
+	src, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return fmt.Errorf("loading embedded migrations: %w", err)
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return fmt.Errorf("opening migration connection: %w", err)
+	}
+	defer db.Close()
+
+	m, err := migratepgx.WithInstance(db, &migratepgx.Config{})
+	if err != nil {
+		return fmt.Errorf("preparing migrate driver: %w", err)
+	}
+	mg, err := migrate.NewWithInstance("iofs", src, "pgx", m)
+	if err != nil {
+		return fmt.Errorf("building migrator: %w", err)
+	}
+	if err := mg.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		return pgErr.SQLState() == "23505"
+	}
+	return false
+}
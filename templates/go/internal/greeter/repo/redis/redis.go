@@ -0,0 +1,113 @@
+// Package redis implements greeter.Repository on top of Redis via
+// go-redis. Greetings are stored as JSON values with an optional TTL,
+// and a set tracks known IDs so List doesn't require a KEYS scan.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+const idsKey = "greeter:ids"
+
+func keyFor(id string) string {
+	return "greeter:greeting:" + id
+}
+
+// Repository is a greeter.Repository backed by Redis.
+type Repository struct {
+	client *redis.Client
+	ttl    time.Duration // 0 means greetings never expire
+}
+
+// New returns a Repository that talks to a Redis server at addr. If
+// ttl is non-zero, stored greetings expire after that duration.
+func New(addr string, ttl time.Duration) *Repository {
+	return &Repository{
+		client: redis.NewClient(&redis.Options{Addr: addr}),
+		ttl:    ttl,
+	}
+}
+
+// Close releases the underlying client's connections.
+func (r *Repository) Close() error {
+	return r.client.Close()
+}
+
+func (r *Repository) Store(ctx context.Context, g greeter.Greeting) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		return fmt.Errorf("marshaling greeting %q: %w", g.ID, err)
+	}
+
+	// SETNX makes the duplicate-ID check and the write one atomic step;
+	// the SADD is a no-op when the ID is already a member.
+	pipe := r.client.TxPipeline()
+	set := pipe.SetNX(ctx, keyFor(g.ID), data, r.ttl)
+	pipe.SAdd(ctx, idsKey, g.ID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("storing greeting %q: %w", g.ID, err)
+	}
+	if !set.Val() {
+		return errs.WithCausef(errs.ErrConflict, "greeting %q already exists", g.ID)
+	}
+	return nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id string) (greeter.Greeting, error) {
+	if err := ctx.Err(); err != nil {
+		return greeter.Greeting{}, err
+	}
+
+	data, err := r.client.Get(ctx, keyFor(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return greeter.Greeting{}, errs.WithCausef(errs.ErrNotFound, "greeting %q", id)
+	}
+	if err != nil {
+		return greeter.Greeting{}, fmt.Errorf("finding greeting %q: %w", id, err)
+	}
+
+	var g greeter.Greeting
+	if err := json.Unmarshal(data, &g); err != nil {
+		return greeter.Greeting{}, fmt.Errorf("unmarshaling greeting %q: %w", id, err)
+	}
+	return g, nil
+}
+
+func (r *Repository) List(ctx context.Context) ([]greeter.Greeting, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ids, err := r.client.SMembers(ctx, idsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("listing greeting ids: %w", err)
+	}
+
+	out := make([]greeter.Greeting, 0, len(ids))
+	for _, id := range ids {
+		g, err := r.FindByID(ctx, id)
+		if errors.Is(err, errs.ErrNotFound) {
+			// Expired via TTL since it was added to idsKey; prune and skip.
+			r.client.SRem(ctx, idsKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, g)
+	}
+	return out, nil
+}
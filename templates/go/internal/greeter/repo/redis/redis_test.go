@@ -0,0 +1,38 @@
+package redis_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/redis"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/repotest"
+)
+
+// TestRedisRepository_Conformance requires a live Redis instance. Set
+// REDIS_ADDR to run it, e.g.:
+//
+//	REDIS_ADDR=localhost:6379 go test ./...
+func TestRedisRepository_Conformance(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set; skipping Redis conformance test")
+	}
+
+	repotest.RunConformance(t, func(t *testing.T) greeter.Repository {
+		t.Helper()
+
+		client := goredis.NewClient(&goredis.Options{Addr: addr})
+		if err := client.FlushDB(context.Background()).Err(); err != nil {
+			t.Fatalf("flushing test db: %v", err)
+		}
+		t.Cleanup(func() { _ = client.Close() })
+
+		repo := redis.New(addr, 0)
+		t.Cleanup(func() { _ = repo.Close() })
+		return repo
+	})
+}
@@ -0,0 +1,132 @@
+// Package repotest provides a shared conformance suite for
+// greeter.Repository implementations. Every backend under
+// internal/greeter/repo/ should pass RunConformance to prove it behaves
+// the same as greeter.MemoryRepository.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+// Factory builds a fresh, empty Repository for a single subtest. Each
+// call must return an independent backing store so subtests don't leak
+// state into one another.
+type Factory func(t *testing.T) greeter.Repository
+
+// RunConformance exercises Store, FindByID, and List against factory,
+// including not-found, duplicate-ID, and context-cancellation behavior.
+// Call it from each backend's own *_test.go, e.g.:
+//
+//	func TestRedisRepository_Conformance(t *testing.T) {
+//		repotest.RunConformance(t, func(t *testing.T) greeter.Repository {
+//			return newTestRepository(t)
+//		})
+//	}
+func RunConformance(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("store and find by id", func(t *testing.T) {
+		repo := factory(t)
+		ctx := context.Background()
+
+		g := greeter.Greeting{
+			ID:        "conformance-1",
+			Name:      "World",
+			Language:  "en",
+			Message:   "Hello, World!",
+			CreatedAt: time.Now().UTC().Truncate(time.Second),
+		}
+		if err := repo.Store(ctx, g); err != nil {
+			t.Fatalf("Store: %v", err)
+		}
+
+		got, err := repo.FindByID(ctx, g.ID)
+		if err != nil {
+			t.Fatalf("FindByID: %v", err)
+		}
+		if got.ID != g.ID || got.Name != g.Name || got.Message != g.Message {
+			t.Errorf("FindByID = %+v, want %+v", got, g)
+		}
+	})
+
+	t.Run("find by id not found", func(t *testing.T) {
+		repo := factory(t)
+		ctx := context.Background()
+
+		_, err := repo.FindByID(ctx, "does-not-exist")
+		if !errors.Is(err, errs.ErrNotFound) {
+			t.Fatalf("expected errors.Is(err, errs.ErrNotFound), got %v", err)
+		}
+	})
+
+	t.Run("store duplicate id is a conflict", func(t *testing.T) {
+		repo := factory(t)
+		ctx := context.Background()
+
+		g := greeter.Greeting{ID: "conformance-dup", Name: "A", Message: "Hello, A!", CreatedAt: time.Now().UTC()}
+		if err := repo.Store(ctx, g); err != nil {
+			t.Fatalf("Store: %v", err)
+		}
+		err := repo.Store(ctx, g)
+		if !errors.Is(err, errs.ErrConflict) {
+			t.Fatalf("expected errors.Is(err, errs.ErrConflict), got %v", err)
+		}
+	})
+
+	t.Run("list returns every stored greeting", func(t *testing.T) {
+		repo := factory(t)
+		ctx := context.Background()
+
+		items, err := repo.List(ctx)
+		if err != nil {
+			t.Fatalf("List (empty): %v", err)
+		}
+		if len(items) != 0 {
+			t.Fatalf("expected 0 items, got %d", len(items))
+		}
+
+		want := map[string]bool{"conformance-a": true, "conformance-b": true}
+		for id := range want {
+			g := greeter.Greeting{ID: id, Name: id, Message: "hi", CreatedAt: time.Now().UTC()}
+			if err := repo.Store(ctx, g); err != nil {
+				t.Fatalf("Store(%s): %v", id, err)
+			}
+		}
+
+		items, err = repo.List(ctx)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(items) != len(want) {
+			t.Fatalf("expected %d items, got %d", len(want), len(items))
+		}
+		for _, g := range items {
+			if !want[g.ID] {
+				t.Errorf("unexpected item %q in List result", g.ID)
+			}
+		}
+	})
+
+	t.Run("context cancellation is respected", func(t *testing.T) {
+		repo := factory(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		g := greeter.Greeting{ID: "conformance-cancelled", Name: "A", Message: "hi", CreatedAt: time.Now().UTC()}
+		if err := repo.Store(ctx, g); !errors.Is(err, context.Canceled) {
+			t.Fatalf("Store with cancelled context: expected context.Canceled, got %v", err)
+		}
+		if _, err := repo.FindByID(ctx, g.ID); !errors.Is(err, context.Canceled) {
+			t.Fatalf("FindByID with cancelled context: expected context.Canceled, got %v", err)
+		}
+		if _, err := repo.List(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("List with cancelled context: expected context.Canceled, got %v", err)
+		}
+	})
+}
@@ -2,9 +2,9 @@ package greeter
 
 import (
 	"context"
-	"fmt"
 	"sync"
-	"time"
+
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
 )
 
 // Repository defines the data-access contract. Implementations must be
@@ -27,24 +27,36 @@ func NewMemoryRepository() *MemoryRepository {
 	return &MemoryRepository{items: make(map[string]Greeting)}
 }
 
-func (r *MemoryRepository) Store(_ context.Context, g Greeting) error {
+func (r *MemoryRepository) Store(ctx context.Context, g Greeting) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	if _, exists := r.items[g.ID]; exists {
+		return errs.WithCausef(errs.ErrConflict, "greeting %q already exists", g.ID)
+	}
 	r.items[g.ID] = g
 	return nil
 }
 
-func (r *MemoryRepository) FindByID(_ context.Context, id string) (Greeting, error) {
+func (r *MemoryRepository) FindByID(ctx context.Context, id string) (Greeting, error) {
+	if err := ctx.Err(); err != nil {
+		return Greeting{}, err
+	}
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	g, ok := r.items[id]
 	if !ok {
-		return Greeting{}, fmt.Errorf("greeting %q not found", id)
+		return Greeting{}, errs.WithCausef(errs.ErrNotFound, "greeting %q", id)
 	}
 	return g, nil
 }
 
-func (r *MemoryRepository) List(_ context.Context) ([]Greeting, error) {
+func (r *MemoryRepository) List(ctx context.Context) ([]Greeting, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	out := make([]Greeting, 0, len(r.items))
@@ -53,9 +65,3 @@ func (r *MemoryRepository) List(_ context.Context) ([]Greeting, error) {
 	}
 	return out, nil
 }
-
-// generateID produces a simple time-based ID. Replace with UUIDv7 in
-// production (e.g., github.com/google/uuid).
-func generateID() string {
-	return fmt.Sprintf("%d", time.Now().UnixNano())
-}
@@ -0,0 +1,15 @@
+package greeter_test
+
+import (
+	"testing"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/repo/repotest"
+)
+
+func TestMemoryRepository_Conformance(t *testing.T) {
+	t.Parallel()
+	repotest.RunConformance(t, func(t *testing.T) greeter.Repository {
+		return greeter.NewMemoryRepository()
+	})
+}
@@ -4,7 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	ometric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
 )
 
 // Service implements the greeting business logic. Create one with
@@ -12,6 +22,16 @@ import (
 type Service struct {
 	repo     Repository
 	language string
+
+	authenticator auth.Authenticator
+	authorizer    auth.Authorizer
+
+	logger *slog.Logger
+	tracer trace.Tracer
+
+	clock func() time.Time
+	idGen func() string
+	idSeq *uuidv7Sequence
 }
 
 // Option configures Service via the functional options pattern.
@@ -39,11 +59,93 @@ func WithDefaultLanguage(lang string) Option {
 	}
 }
 
+// WithAuthenticator sets the Authenticator transport middleware should
+// use to turn a bearer token into an auth.Account — see Authenticator.
+// Optional; without one, Create/Get/List run unauthenticated.
+func WithAuthenticator(a auth.Authenticator) Option {
+	return func(s *Service) error {
+		s.authenticator = a
+		return nil
+	}
+}
+
+// WithAuthorizer sets the Authorizer consulted before every
+// Create/Get/List call. Optional; without one, all calls are allowed.
+func WithAuthorizer(a auth.Authorizer) Option {
+	return func(s *Service) error {
+		s.authorizer = a
+		return nil
+	}
+}
+
+// Authenticator returns the Authenticator configured via
+// WithAuthenticator, for transport middleware to use. It is nil if
+// none was configured.
+func (s *Service) Authenticator() auth.Authenticator {
+	return s.authenticator
+}
+
+// WithLogger sets the structured logger Service uses in place of
+// fmt.Errorf-style ad-hoc messages. Defaults to slog.Default().
+func WithLogger(l *slog.Logger) Option {
+	return func(s *Service) error {
+		if l == nil {
+			return errors.New("logger must not be nil")
+		}
+		s.logger = l
+		return nil
+	}
+}
+
+// WithTracerProvider sets the trace.TracerProvider Service draws its
+// tracer from. Defaults to the global provider (a no-op until the
+// application configures one), so this is only needed to scope
+// tracing to a provider other than the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(s *Service) error {
+		if tp == nil {
+			return errors.New("tracer provider must not be nil")
+		}
+		s.tracer = tp.Tracer(instrumentationName)
+		return nil
+	}
+}
+
+// WithClock sets the func Service uses to read the current time, for
+// Greeting.CreatedAt and the default UUIDv7 ID generator. Defaults to
+// time.Now; tests inject a fixed clock for deterministic output.
+func WithClock(clock func() time.Time) Option {
+	return func(s *Service) error {
+		if clock == nil {
+			return errors.New("clock must not be nil")
+		}
+		s.clock = clock
+		return nil
+	}
+}
+
+// WithIDGenerator overrides how Service generates Greeting IDs,
+// bypassing the default UUIDv7 sequence entirely. Tests use this to
+// inject deterministic, predictable IDs.
+func WithIDGenerator(gen func() string) Option {
+	return func(s *Service) error {
+		if gen == nil {
+			return errors.New("id generator must not be nil")
+		}
+		s.idGen = gen
+		return nil
+	}
+}
+
 // NewService constructs a Service. WithRepository is required; other
 // options are optional with sensible defaults.
 func NewService(opts ...Option) (*Service, error) {
 	s := &Service{
 		language: "en",
+		logger:   slog.Default(),
+		tracer:   otel.Tracer(instrumentationName),
+		clock:    time.Now,
+		idSeq:    &uuidv7Sequence{},
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
@@ -58,9 +160,28 @@ func NewService(opts ...Option) (*Service, error) {
 
 // Create validates input, builds a greeting, persists it, and returns
 // the stored entity.
-func (s *Service) Create(ctx context.Context, in CreateInput) (Greeting, error) {
+func (s *Service) Create(ctx context.Context, in CreateInput) (g Greeting, err error) {
+	ctx, span := s.tracer.Start(ctx, "greeter.Service.Create")
+	start := time.Now()
+	defer func() {
+		lang := in.Language
+		if lang == "" {
+			lang = s.language
+		}
+		createTotal.Add(ctx, 1, ometric.WithAttributes(
+			attribute.String("language", lang),
+			attribute.String("status", outcome(err)),
+		))
+		s.endSpan(ctx, span, start, "Create", err)
+	}()
+
+	acc, err := s.verify(ctx, auth.Resource{Type: "greeting", Action: "create"})
+	if err != nil {
+		return Greeting{}, err
+	}
+
 	if err := in.Validate(); err != nil {
-		return Greeting{}, fmt.Errorf("invalid input: %w", err)
+		return Greeting{}, errs.WithCausef(errs.ErrInvalidInput, "invalid input: %v", err)
 	}
 
 	lang := in.Language
@@ -68,31 +189,159 @@ func (s *Service) Create(ctx context.Context, in CreateInput) (Greeting, error)
 		lang = s.language
 	}
 
-	g := Greeting{
-		ID:        generateID(),
+	g = Greeting{
+		ID:        s.generateID(),
 		Name:      in.Name,
 		Language:  lang,
 		Message:   buildMessage(lang, in.Name),
-		CreatedAt: time.Now().UTC(),
+		CreatedAt: s.clock().UTC(),
+		OwnerID:   acc.ID,
 	}
+	span.SetAttributes(
+		attribute.String("greeting.id", g.ID),
+		attribute.String("greeting.language", g.Language),
+		attribute.Int("greeting.name.length", len(g.Name)),
+	)
 
-	if err := s.repo.Store(ctx, g); err != nil {
+	if err = s.storeInRepo(ctx, g); err != nil {
+		s.logger.ErrorContext(ctx, "storing greeting failed", "greeting.id", g.ID, "error", err)
 		return Greeting{}, fmt.Errorf("storing greeting: %w", err)
 	}
 	return g, nil
 }
 
 // Get retrieves a greeting by ID.
-func (s *Service) Get(ctx context.Context, id string) (Greeting, error) {
+func (s *Service) Get(ctx context.Context, id string) (g Greeting, err error) {
+	ctx, span := s.tracer.Start(ctx, "greeter.Service.Get", trace.WithAttributes(attribute.String("greeting.id", id)))
+	start := time.Now()
+	defer func() { s.endSpan(ctx, span, start, "Get", err) }()
+
+	if _, err = s.verify(ctx, auth.Resource{Type: "greeting", Action: "get"}); err != nil {
+		return Greeting{}, err
+	}
 	if id == "" {
-		return Greeting{}, errors.New("id is required")
+		return Greeting{}, errs.WithCausef(errs.ErrInvalidInput, "id is required")
 	}
-	return s.repo.FindByID(ctx, id)
+
+	g, err = s.findInRepo(ctx, id)
+	if err != nil {
+		s.logger.WarnContext(ctx, "getting greeting failed", "greeting.id", id, "error", err)
+		return Greeting{}, err
+	}
+	return g, nil
 }
 
-// List returns all greetings.
-func (s *Service) List(ctx context.Context) ([]Greeting, error) {
-	return s.repo.List(ctx)
+// List returns every greeting visible to the caller: all of them when
+// no auth.Authorizer is configured or the caller holds auth.ScopePublic,
+// otherwise just the greetings the caller owns.
+func (s *Service) List(ctx context.Context) (out []Greeting, err error) {
+	ctx, span := s.tracer.Start(ctx, "greeter.Service.List")
+	start := time.Now()
+	defer func() { s.endSpan(ctx, span, start, "List", err) }()
+
+	acc, err := s.verify(ctx, auth.Resource{Type: "greeting", Action: "list"})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.listInRepo(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "listing greetings failed", "error", err)
+		return nil, err
+	}
+	if s.authorizer == nil || acc.HasScope(auth.ScopePublic) {
+		return items, nil
+	}
+
+	owned := make([]Greeting, 0, len(items))
+	for _, g := range items {
+		if g.OwnerID == acc.ID {
+			owned = append(owned, g)
+		}
+	}
+	return owned, nil
+}
+
+// storeInRepo, findInRepo, and listInRepo wrap the Repository calls in
+// their own greeter.Repository.* spans, matching the naming Service's
+// own methods use.
+func (s *Service) storeInRepo(ctx context.Context, g Greeting) error {
+	ctx, span := s.tracer.Start(ctx, "greeter.Repository.Store", trace.WithAttributes(
+		attribute.String("greeting.id", g.ID),
+		attribute.String("greeting.language", g.Language),
+	))
+	defer span.End()
+
+	err := s.repo.Store(ctx, g)
+	recordSpanError(span, err)
+	return err
+}
+
+func (s *Service) findInRepo(ctx context.Context, id string) (Greeting, error) {
+	ctx, span := s.tracer.Start(ctx, "greeter.Repository.FindByID", trace.WithAttributes(attribute.String("greeting.id", id)))
+	defer span.End()
+
+	g, err := s.repo.FindByID(ctx, id)
+	recordSpanError(span, err)
+	return g, err
+}
+
+func (s *Service) listInRepo(ctx context.Context) ([]Greeting, error) {
+	ctx, span := s.tracer.Start(ctx, "greeter.Repository.List")
+	defer span.End()
+
+	items, err := s.repo.List(ctx)
+	recordSpanError(span, err)
+	return items, err
+}
+
+// endSpan records err on span, ends it, and records the
+// request_duration_seconds histogram for the named Service operation.
+func (s *Service) endSpan(ctx context.Context, span trace.Span, start time.Time, operation string, err error) {
+	recordSpanError(span, err)
+	span.End()
+	requestDuration.Record(ctx, time.Since(start).Seconds(), ometric.WithAttributes(
+		attribute.String("method", operation),
+		attribute.String("status", outcome(err)),
+	))
+}
+
+// generateID returns the ID for a new Greeting: idGen if set via
+// WithIDGenerator, otherwise the next value from the default UUIDv7
+// sequence, timestamped using clock.
+func (s *Service) generateID() string {
+	if s.idGen != nil {
+		return s.idGen()
+	}
+	return s.idSeq.next(s.clock())
+}
+
+func recordSpanError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// verify extracts the caller's Account from ctx (populated by
+// transport middleware via auth.WithAccount) and, if an Authorizer is
+// configured, confirms it may access res.
+func (s *Service) verify(ctx context.Context, res auth.Resource) (auth.Account, error) {
+	acc, _ := auth.AccountFromContext(ctx)
+	if s.authorizer == nil {
+		return acc, nil
+	}
+	if err := s.authorizer.Verify(ctx, acc, res); err != nil {
+		return auth.Account{}, err
+	}
+	return acc, nil
 }
 
 func buildMessage(lang, name string) string {
@@ -2,9 +2,16 @@ package greeter_test
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
 )
 
 // newTestService is a helper that creates a service backed by the
@@ -73,8 +80,8 @@ func TestService_Create(t *testing.T) {
 
 			got, err := svc.Create(ctx, tt.input)
 			if tt.wantErr {
-				if err == nil {
-					t.Fatal("expected error, got nil")
+				if !errors.Is(err, errs.ErrInvalidInput) {
+					t.Fatalf("expected errors.Is(err, errs.ErrInvalidInput), got %v", err)
 				}
 				return
 			}
@@ -114,14 +121,14 @@ func TestService_Get(t *testing.T) {
 
 	// Not found
 	_, err = svc.Get(ctx, "nonexistent")
-	if err == nil {
-		t.Fatal("expected error for nonexistent ID")
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected errors.Is(err, errs.ErrNotFound), got %v", err)
 	}
 
 	// Empty ID
 	_, err = svc.Get(ctx, "")
-	if err == nil {
-		t.Fatal("expected error for empty ID")
+	if !errors.Is(err, errs.ErrInvalidInput) {
+		t.Fatalf("expected errors.Is(err, errs.ErrInvalidInput), got %v", err)
 	}
 }
 
@@ -156,6 +163,76 @@ func TestService_List(t *testing.T) {
 	}
 }
 
+func TestService_Authorization(t *testing.T) {
+	t.Parallel()
+
+	authz := auth.NewStaticAuthorizer(
+		auth.Rule{Scope: auth.ScopeAccount, Type: "greeting", Action: "create"},
+		auth.Rule{Scope: auth.ScopeAccount, Type: "greeting", Action: "get"},
+		auth.Rule{Scope: auth.ScopePublic, Type: "greeting"},
+	)
+	svc := newTestService(t, greeter.WithAuthorizer(authz))
+
+	// No account in context at all: every rule requires a scope the
+	// zero-value Account doesn't have.
+	if _, err := svc.Create(context.Background(), greeter.CreateInput{Name: "World"}); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected errors.Is(err, errs.ErrForbidden), got %v", err)
+	}
+
+	// An account-scoped caller may create and get, but not list.
+	ctx := auth.WithAccount(context.Background(), auth.Account{ID: "u1", Scopes: []string{"account"}})
+	created, err := svc.Create(ctx, greeter.CreateInput{Name: "World"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.OwnerID != "u1" {
+		t.Errorf("OwnerID = %q, want %q", created.OwnerID, "u1")
+	}
+	if _, err := svc.Get(ctx, created.ID); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, err := svc.List(ctx); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected errors.Is(err, errs.ErrForbidden), got %v", err)
+	}
+}
+
+func TestService_List_FiltersByOwner(t *testing.T) {
+	t.Parallel()
+
+	authz := auth.NewStaticAuthorizer(
+		auth.Rule{Scope: auth.ScopeAccount, Type: "greeting"},
+		auth.Rule{Scope: auth.ScopePublic, Type: "greeting"},
+	)
+	svc := newTestService(t, greeter.WithAuthorizer(authz))
+
+	ctxU1 := auth.WithAccount(context.Background(), auth.Account{ID: "u1", Scopes: []string{"account"}})
+	ctxU2 := auth.WithAccount(context.Background(), auth.Account{ID: "u2", Scopes: []string{"account"}})
+	ctxAdmin := auth.WithAccount(context.Background(), auth.Account{ID: "admin", Scopes: []string{"public"}})
+
+	if _, err := svc.Create(ctxU1, greeter.CreateInput{Name: "A"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := svc.Create(ctxU2, greeter.CreateInput{Name: "B"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	items, err := svc.List(ctxU1)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(items) != 1 || items[0].OwnerID != "u1" {
+		t.Fatalf("List(u1) = %+v, want exactly u1's greeting", items)
+	}
+
+	items, err = svc.List(ctxAdmin)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("List(admin) = %d items, want 2", len(items))
+	}
+}
+
 func TestNewService_Validation(t *testing.T) {
 	t.Parallel()
 
@@ -179,4 +256,73 @@ func TestNewService_Validation(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error with empty language")
 	}
+
+	// Nil logger
+	_, err = greeter.NewService(
+		greeter.WithRepository(greeter.NewMemoryRepository()),
+		greeter.WithLogger(nil),
+	)
+	if err == nil {
+		t.Fatal("expected error with nil logger")
+	}
+
+	// Nil tracer provider
+	_, err = greeter.NewService(
+		greeter.WithRepository(greeter.NewMemoryRepository()),
+		greeter.WithTracerProvider(nil),
+	)
+	if err == nil {
+		t.Fatal("expected error with nil tracer provider")
+	}
+}
+
+func TestService_WithClockAndIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	wantCreatedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	svc := newTestService(t,
+		greeter.WithClock(func() time.Time { return wantCreatedAt }),
+		greeter.WithIDGenerator(func() string { return "fixed-id" }),
+	)
+
+	got, err := svc.Create(context.Background(), greeter.CreateInput{Name: "World"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got.ID != "fixed-id" {
+		t.Errorf("ID = %q, want %q", got.ID, "fixed-id")
+	}
+	if !got.CreatedAt.Equal(wantCreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, wantCreatedAt)
+	}
+}
+
+func TestService_DefaultIDGenerator_ProducesUUIDv7(t *testing.T) {
+	t.Parallel()
+	svc := newTestService(t)
+
+	got, err := svc.Create(context.Background(), greeter.CreateInput{Name: "World"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := greeter.ParseID(got.ID); err != nil {
+		t.Errorf("ParseID(%q): %v", got.ID, err)
+	}
+}
+
+func TestService_WithLoggerAndTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	svc := newTestService(t,
+		greeter.WithLogger(slog.Default()),
+		greeter.WithTracerProvider(noop.NewTracerProvider()),
+	)
+
+	got, err := svc.Create(context.Background(), greeter.CreateInput{Name: "World"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got.Message != "Hello, World!" {
+		t.Errorf("message = %q, want %q", got.Message, "Hello, World!")
+	}
 }
@@ -0,0 +1,28 @@
+package greeter
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// instrumentationName identifies this package to OpenTelemetry tracers
+// and meters, following the convention of using the importable path.
+const instrumentationName = "{{PROJECT_MODULE}}/internal/greeter"
+
+// meter reads from the global otel.MeterProvider, which the
+// application wires up at startup (e.g. to the Prometheus exporter).
+// Until then it's a no-op, so importing this package has no cost for
+// callers that don't configure OpenTelemetry.
+var meter = otel.Meter(instrumentationName)
+
+var (
+	createTotal, _ = meter.Int64Counter(
+		"greeter_create_total",
+		metric.WithDescription("Number of Service.Create calls, by language and outcome."),
+	)
+	requestDuration, _ = meter.Float64Histogram(
+		"greeter_request_duration_seconds",
+		metric.WithDescription("Service method latency in seconds, by method and outcome."),
+		metric.WithUnit("s"),
+	)
+)
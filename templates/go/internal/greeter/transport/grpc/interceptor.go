@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+)
+
+// UnaryInterceptor returns the grpc.ServerOption that authenticates
+// unary calls against svc. Pass it to grpc.NewServer alongside
+// RegisterGreeterServiceServer. When svc was not built with
+// greeter.WithAuthenticator, the returned interceptor is a no-op.
+func UnaryInterceptor(svc *greeter.Service) grpc.ServerOption {
+	return grpc.UnaryInterceptor(authInterceptor(svc))
+}
+
+// authInterceptor mirrors Handler.ServeHTTP for gRPC: it verifies the
+// "authorization: Bearer <token>" metadata and populates the RPC
+// context with the resulting auth.Account before invoking handler.
+func authInterceptor(svc *greeter.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		authn := svc.Authenticator()
+		if authn == nil {
+			return handler(ctx, req)
+		}
+
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization: Bearer metadata")
+		}
+
+		acc, err := authn.Authenticate(ctx, token)
+		if err != nil {
+			return nil, status.Error(grpcCode(err), err.Error())
+		}
+
+		return handler(auth.WithAccount(ctx, acc), req)
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	const prefix = "Bearer "
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, v := range md.Get("authorization") {
+		if strings.HasPrefix(v, prefix) {
+			return strings.TrimPrefix(v, prefix), true
+		}
+	}
+	return "", false
+}
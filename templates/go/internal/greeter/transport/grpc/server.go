@@ -0,0 +1,92 @@
+// Package grpc adapts greeter.Service to the gRPC service defined in
+// proto/greeter/v1/greeter.proto. Run generate.sh first — this file
+// depends on the greeterv1 types it emits into proto/greeter/v1.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+	greeterv1 "{{PROJECT_MODULE}}/proto/greeter/v1"
+)
+
+// Server implements greeterv1.GreeterServiceServer on top of a
+// greeter.Service. Register it with grpc.NewServer via
+// greeterv1.RegisterGreeterServiceServer.
+type Server struct {
+	greeterv1.UnimplementedGreeterServiceServer
+	svc *greeter.Service
+}
+
+// NewServer returns a gRPC server backed by svc.
+func NewServer(svc *greeter.Service) *Server {
+	return &Server{svc: svc}
+}
+
+func (s *Server) CreateGreeting(ctx context.Context, req *greeterv1.CreateGreetingRequest) (*greeterv1.Greeting, error) {
+	g, err := s.svc.Create(ctx, greeter.CreateInput{
+		Name:     req.GetName(),
+		Language: req.GetLanguage(),
+	})
+	if err != nil {
+		return nil, status.Error(grpcCode(err), err.Error())
+	}
+	return toProto(g), nil
+}
+
+func (s *Server) GetGreeting(ctx context.Context, req *greeterv1.GetGreetingRequest) (*greeterv1.Greeting, error) {
+	g, err := s.svc.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Error(grpcCode(err), err.Error())
+	}
+	return toProto(g), nil
+}
+
+func (s *Server) ListGreetings(ctx context.Context, _ *greeterv1.ListGreetingsRequest) (*greeterv1.ListGreetingsResponse, error) {
+	items, err := s.svc.List(ctx)
+	if err != nil {
+		return nil, status.Error(grpcCode(err), err.Error())
+	}
+	out := make([]*greeterv1.Greeting, 0, len(items))
+	for _, g := range items {
+		out = append(out, toProto(g))
+	}
+	return &greeterv1.ListGreetingsResponse{Greetings: out}, nil
+}
+
+// grpcCode maps an error produced by the errs package's sentinels to
+// the gRPC status code a client should see, mirroring errs.HTTPStatus
+// for the REST transport. Unrecognized errors map to codes.Internal so
+// callers never mistake a server bug for a real not-found/permission
+// failure.
+func grpcCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, errs.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, errs.ErrInvalidInput):
+		return codes.InvalidArgument
+	case errors.Is(err, errs.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, errs.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, errs.ErrInvalidToken):
+		return codes.Unauthenticated
+	default:
+		return codes.Internal
+	}
+}
+
+func toProto(g greeter.Greeting) *greeterv1.Greeting {
+	return &greeterv1.Greeting{
+		Id:        g.ID,
+		Name:      g.Name,
+		Language:  g.Language,
+		Message:   g.Message,
+		CreatedAt: g.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,7 @@
+// Package http exposes greeter.Service over a plain REST/JSON API built
+// on net/http. It is the transport layer's hand-written counterpart to
+// the generated gRPC server in the sibling grpc package — both front
+// the same Service and are kept in sync by proto/greeter/v1/greeter.proto,
+// whose google.api.http annotations document (but do not generate) this
+// package's route mapping.
+package http
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+	"{{PROJECT_MODULE}}/internal/greeter/errs"
+)
+
+// Handler adapts greeter.Service to net/http. Construct one with
+// NewHandler and mount it directly, or embed it in a larger mux.
+type Handler struct {
+	svc *greeter.Service
+	mux *http.ServeMux
+}
+
+// NewHandler builds a Handler backed by svc, routing the REST surface
+// described in proto/greeter/v1/greeter.proto.
+func NewHandler(svc *greeter.Service) *Handler {
+	h := &Handler{svc: svc, mux: http.NewServeMux()}
+	h.mux.HandleFunc("POST /v1/greetings", h.create)
+	h.mux.HandleFunc("GET /v1/greetings/{id}", h.get)
+	h.mux.HandleFunc("GET /v1/greetings", h.list)
+	return h
+}
+
+// ServeHTTP implements http.Handler. When svc was built with
+// greeter.WithAuthenticator, it first verifies the Authorization:
+// Bearer <token> header and populates the request context with the
+// resulting auth.Account before routing to the handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authn := h.svc.Authenticator()
+	if authn == nil {
+		h.mux.ServeHTTP(w, r)
+		return
+	}
+
+	token, ok := bearerToken(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, errs.WithCausef(errs.ErrInvalidToken, "missing Authorization: Bearer header"))
+		return
+	}
+
+	acc, err := authn.Authenticate(r.Context(), token)
+	if err != nil {
+		writeError(w, errs.HTTPStatus(err), err)
+		return
+	}
+
+	ctx := auth.WithAccount(r.Context(), acc)
+	h.mux.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(h, prefix), true
+}
+
+type createGreetingRequest struct {
+	Name     string `json:"name"`
+	Language string `json:"language"`
+}
+
+func (h *Handler) create(w http.ResponseWriter, r *http.Request) {
+	var req createGreetingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	g, err := h.svc.Create(r.Context(), greeter.CreateInput{
+		Name:     req.Name,
+		Language: req.Language,
+	})
+	if err != nil {
+		writeError(w, errs.HTTPStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, g)
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	g, err := h.svc.Get(r.Context(), id)
+	if err != nil {
+		writeError(w, errs.HTTPStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
+	items, err := h.svc.List(r.Context())
+	if err != nil {
+		writeError(w, errs.HTTPStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
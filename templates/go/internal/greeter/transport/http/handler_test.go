@@ -0,0 +1,135 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"{{PROJECT_MODULE}}/internal/greeter"
+	"{{PROJECT_MODULE}}/internal/greeter/auth"
+	greeterhttp "{{PROJECT_MODULE}}/internal/greeter/transport/http"
+)
+
+// stubAuthenticator treats its token as the account ID directly, with
+// no real verification — good enough to exercise the middleware.
+type stubAuthenticator struct{}
+
+func (stubAuthenticator) Authenticate(_ context.Context, token string) (auth.Account, error) {
+	return auth.Account{ID: token}, nil
+}
+
+func newTestHandler(t *testing.T) *greeterhttp.Handler {
+	t.Helper()
+	svc, err := greeter.NewService(greeter.WithRepository(greeter.NewMemoryRepository()))
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	return greeterhttp.NewHandler(svc)
+}
+
+func TestHandler_CreateAndGet(t *testing.T) {
+	t.Parallel()
+	h := newTestHandler(t)
+
+	body, _ := json.Marshal(map[string]string{"name": "World"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/greetings", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+
+	var created greeter.Greeting
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if created.Message != "Hello, World!" {
+		t.Errorf("message = %q, want %q", created.Message, "Hello, World!")
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/greetings/"+created.ID, nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandler_GetNotFound(t *testing.T) {
+	t.Parallel()
+	h := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/greetings/missing", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandler_RequiresBearerToken(t *testing.T) {
+	t.Parallel()
+
+	svc, err := greeter.NewService(
+		greeter.WithRepository(greeter.NewMemoryRepository()),
+		greeter.WithAuthenticator(stubAuthenticator{}),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	h := greeterhttp.NewHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/greetings", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("without token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/greetings", nil)
+	req.Header.Set("Authorization", "Bearer u1")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("with token: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandler_List(t *testing.T) {
+	t.Parallel()
+	h := newTestHandler(t)
+
+	create := func(name string) {
+		body, _ := json.Marshal(map[string]string{"name": name})
+		req := httptest.NewRequest(http.MethodPost, "/v1/greetings", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("create %q: status = %d", name, rec.Code)
+		}
+	}
+	create("A")
+	create("B")
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/greetings", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var items []greeter.Greeting
+	if err := json.Unmarshal(rec.Body.Bytes(), &items); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("len(items) = %d, want 2", len(items))
+	}
+}